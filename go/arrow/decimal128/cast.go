@@ -0,0 +1,93 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decimal128
+
+import (
+	"fmt"
+	"math"
+)
+
+// TODO(chunk0-5): ToDecimal256(v Num) decimal256.Num and
+// FromDecimal256(v decimal256.Num) (Num, bool) are NOT implemented here.
+// This tree has no decimal256 package for Num to bridge to/from, so the
+// cross-decimal-width casts arrow compute kernels need are still
+// unavailable; only same-width precision/scale casts via CastTo below are
+// covered by this change. Add the two helpers above (mirroring CastTo's
+// rescale-then-FitsInPrecision shape) once a decimal256 package exists.
+
+// FromInt32 returns a new signed 128-bit integer value from the provided
+// int32 one.
+func FromInt32(v int32) Num {
+	return FromI64(int64(v))
+}
+
+// FromUint64Pair returns a new decimal128.Num from the raw hi and lo words
+// of its two's complement representation. Like New, both words are taken
+// as-is, but hi is given as a uint64, which is useful when the words come from
+// another width's raw representation (e.g. the low 128 bits of a wider
+// decimal) rather than from a signed int64.
+func FromUint64Pair(hi, lo uint64) Num {
+	return Num{hi: int64(hi), lo: lo}
+}
+
+// ToInt64 truncates n, treated as a decimal value scaled by scale, towards
+// zero and returns it as an int64. The second return value is false if the
+// truncated value does not fit in an int64.
+func (n Num) ToInt64(scale int32) (int64, bool) {
+	whole := n
+	switch {
+	case scale > 0:
+		whole = n.ReduceScaleBy(scale, false)
+	case scale < 0:
+		var err error
+		if whole, err = n.RescaleMode(0, -scale, RoundDown); err != nil {
+			return 0, false
+		}
+	}
+
+	switch whole.hi {
+	case 0:
+		if whole.lo > math.MaxInt64 {
+			return 0, false
+		}
+		return int64(whole.lo), true
+	case -1:
+		v := int64(whole.lo)
+		if v >= 0 {
+			return 0, false
+		}
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+// CastTo rescales d to the target scale using mode and checks that the
+// result fits in the target precision, combining the rescale and overflow
+// checks a cross-precision/cross-scale cast within decimal128 needs into a
+// single call. It does not cast across decimal widths (e.g. to or from
+// decimal256); see the TODO above for that gap.
+func (d Decimal) CastTo(prec, scale int32, mode RoundingMode) (Num, error) {
+	out, err := d.Num.RescaleMode(d.Scale, scale, mode)
+	if err != nil {
+		return Num{}, err
+	}
+	if !out.FitsInPrecision(prec) {
+		return Num{}, fmt.Errorf("arrow/decimal128: value %s overflows decimal128(precision=%d, scale=%d)", out.ToString(scale), prec, scale)
+	}
+	return out, nil
+}
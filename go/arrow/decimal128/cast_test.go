@@ -0,0 +1,86 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decimal128
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFromInt32(t *testing.T) {
+	for _, v := range []int32{0, 1, -1, 2147483647, -2147483648} {
+		if got, want := FromInt32(v), FromI64(int64(v)); got != want {
+			t.Fatalf("FromInt32(%d) = %s, want %s", v, got.ToString(0), want.ToString(0))
+		}
+	}
+}
+
+func TestFromUint64Pair(t *testing.T) {
+	// Both words are taken as-is; hi is reinterpreted, not sign-extended.
+	n := FromUint64Pair(1, 2)
+	if n.HighBits() != 1 || n.LowBits() != 2 {
+		t.Fatalf("got hi=%d lo=%d, want hi=1 lo=2", n.HighBits(), n.LowBits())
+	}
+
+	n = FromUint64Pair(^uint64(0), 0)
+	if n.HighBits() != -1 || n.LowBits() != 0 {
+		t.Fatalf("got hi=%d lo=%d, want hi=-1 lo=0", n.HighBits(), n.LowBits())
+	}
+}
+
+func TestToInt64(t *testing.T) {
+	for _, tc := range []struct {
+		n      Num
+		scale  int32
+		want   int64
+		wantOk bool
+	}{
+		{FromI64(1234), 2, 12, true},
+		{FromI64(-1234), 2, -12, true},
+		{FromI64(1200), -1, 12000, true},
+		{FromI64(math.MaxInt64), 0, math.MaxInt64, true},
+		{MaxDecimal128, 0, 0, false},
+	} {
+		got, ok := tc.n.ToInt64(tc.scale)
+		if ok != tc.wantOk {
+			t.Fatalf("ToInt64(%d): ok = %v, want %v", tc.scale, ok, tc.wantOk)
+		}
+		if ok && got != tc.want {
+			t.Fatalf("ToInt64(%d) = %d, want %d", tc.scale, got, tc.want)
+		}
+	}
+}
+
+func TestDecimalCastTo(t *testing.T) {
+	d := Decimal{Num: FromI64(12345), Precision: 18, Scale: 2}
+
+	out, err := d.CastTo(18, 1, RoundHalfUp)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := FromI64(1235); out != want {
+		t.Fatalf("got %s, want %s", out.ToString(1), want.ToString(1))
+	}
+
+	if _, err := d.CastTo(2, 2, RoundHalfUp); err == nil {
+		t.Fatal("expected overflow error: 123.45 does not fit in precision 2")
+	}
+
+	if _, err := d.CastTo(18, 1, RoundUnnecessary); err == nil {
+		t.Fatal("expected error: cast from scale 2 to 1 loses the trailing digit")
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"math/bits"
 
 	"github.com/apache/arrow/go/v10/arrow/internal/debug"
 )
@@ -101,6 +102,160 @@ func (n Num) Negate() Num {
 	return n
 }
 
+// Add returns n + rhs as a new decimal128.Num, operating directly on the
+// (hi, lo) words. Like the rest of Num's arithmetic, overflow wraps rather
+// than panicking or erroring.
+func (n Num) Add(rhs Num) Num {
+	lo, carry := bits.Add64(n.lo, rhs.lo, 0)
+	hi, _ := bits.Add64(uint64(n.hi), uint64(rhs.hi), carry)
+	return Num{lo: lo, hi: int64(hi)}
+}
+
+// Sub returns n - rhs as a new decimal128.Num, operating directly on the
+// (hi, lo) words. Like the rest of Num's arithmetic, overflow wraps rather
+// than panicking or erroring.
+func (n Num) Sub(rhs Num) Num {
+	lo, borrow := bits.Sub64(n.lo, rhs.lo, 0)
+	hi, _ := bits.Sub64(uint64(n.hi), uint64(rhs.hi), borrow)
+	return Num{lo: lo, hi: int64(hi)}
+}
+
+// Mul returns n * rhs as a new decimal128.Num, keeping only the low 128 bits
+// of the full 256-bit product (the same bits a two's complement multiply
+// would keep, regardless of how the overflowed high bits are interpreted).
+func (n Num) Mul(rhs Num) Num {
+	ahi, alo := uint64(n.hi), n.lo
+	bhi, blo := uint64(rhs.hi), rhs.lo
+
+	hi, lo := bits.Mul64(alo, blo)
+	hi += ahi*blo + alo*bhi
+	return Num{lo: lo, hi: int64(hi)}
+}
+
+// div64by64 divides the 128-bit unsigned value (hi:lo) by y, returning the
+// 128-bit quotient as (qhi, qlo) and the remainder. y must be non-zero;
+// panics on division by zero.
+func div64by64(hi, lo, y uint64) (qhi, qlo, r uint64) {
+	r, qhi = hi, 0
+	if r >= y {
+		qhi = r / y
+		r %= y
+	}
+	qlo, r = bits.Div64(r, lo, y)
+	return
+}
+
+// quorem128 computes the unsigned 128-bit by 128-bit quotient and remainder
+// of (uhi:ulo) / (vhi:vlo) using math/bits primitives for the 128-by-64
+// fast path, falling back to a bit-by-bit long division (mirroring the
+// shift-subtract routines in math/big/arith.go) when the divisor itself
+// does not fit in 64 bits.
+func quorem128(uhi, ulo, vhi, vlo uint64) (qhi, qlo, rhi, rlo uint64) {
+	if vlo == 0 && vhi == 0 {
+		panic("arrow/decimal128: division by zero")
+	}
+
+	if vhi == 0 {
+		qhi, qlo, rlo = div64by64(uhi, ulo, vlo)
+		return qhi, qlo, 0, rlo
+	}
+
+	for i := 127; i >= 0; i-- {
+		rhi = rhi<<1 | rlo>>63
+		rlo <<= 1
+		var bit uint64
+		if i >= 64 {
+			bit = (uhi >> uint(i-64)) & 1
+		} else {
+			bit = (ulo >> uint(i)) & 1
+		}
+		rlo |= bit
+
+		if rhi > vhi || (rhi == vhi && rlo >= vlo) {
+			borrow := uint64(0)
+			rlo, borrow = bits.Sub64(rlo, vlo, 0)
+			rhi, _ = bits.Sub64(rhi, vhi, borrow)
+			if i >= 64 {
+				qhi |= 1 << uint(i-64)
+			} else {
+				qlo |= 1 << uint(i)
+			}
+		}
+	}
+	return
+}
+
+// QuoRem returns the quotient and remainder of n / rhs, truncated towards
+// zero (the remainder takes the sign of n), matching the semantics of
+// big.Int.QuoRem. Panics if rhs is zero.
+func (n Num) QuoRem(rhs Num) (q, r Num) {
+	negN, negD := n.Sign() < 0, rhs.Sign() < 0
+	absN, absD := n.Abs(), rhs.Abs()
+
+	qhi, qlo, rhi, rlo := quorem128(uint64(absN.hi), absN.lo, uint64(absD.hi), absD.lo)
+	q, r = Num{hi: int64(qhi), lo: qlo}, Num{hi: int64(rhi), lo: rlo}
+	if negN != negD {
+		q = q.Negate()
+	}
+	if negN {
+		r = r.Negate()
+	}
+	return
+}
+
+// Cmp compares n to other and returns:
+//
+// -1 if n <  other
+//  0 if n == other
+// +1 if n >  other
+func (n Num) Cmp(other Num) int {
+	switch {
+	case n.hi > other.hi:
+		return 1
+	case n.hi < other.hi:
+		return -1
+	case n.lo > other.lo:
+		return 1
+	case n.lo < other.lo:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// Lsh returns n shifted left by s bits (0 <= s <= 127), with bits shifted
+// out of the top simply discarded.
+func (n Num) Lsh(s uint) Num {
+	switch {
+	case s == 0:
+		return n
+	case s < 64:
+		return Num{hi: int64(uint64(n.hi)<<s | n.lo>>(64-s)), lo: n.lo << s}
+	case s < 128:
+		return Num{hi: int64(n.lo << (s - 64)), lo: 0}
+	default:
+		return Num{}
+	}
+}
+
+// Rsh returns n shifted right by s bits (0 <= s <= 127), sign-extending
+// from the top to preserve the two's complement value for negative n.
+func (n Num) Rsh(s uint) Num {
+	switch {
+	case s == 0:
+		return n
+	case s < 64:
+		return Num{hi: n.hi >> s, lo: (n.lo >> s) | (uint64(n.hi) << (64 - s))}
+	case s < 128:
+		return Num{hi: n.hi >> 63, lo: uint64(n.hi >> (s - 64))}
+	default:
+		if n.hi < 0 {
+			return Num{hi: -1, lo: ^uint64(0)}
+		}
+		return Num{}
+	}
+}
+
 func fromPositiveFloat64(v float64, prec, scale int32) (Num, error) {
 	var pscale float64
 	if scale >= -38 && scale <= 38 {
@@ -214,8 +369,7 @@ func (n Num) IncreaseScaleBy(increase int32) Num {
 	debug.Assert(increase >= 0, "invalid increase scale for decimal128")
 	debug.Assert(increase <= 38, "invalid increase scale for decimal128")
 
-	v := scaleMultipliers[increase].BigInt()
-	return FromBigInt(v.Mul(n.BigInt(), v))
+	return n.Mul(scaleMultipliers[increase])
 }
 
 // ReduceScaleBy returns a new decimal128.Num with the value scaled down by
@@ -224,65 +378,23 @@ func (n Num) IncreaseScaleBy(increase int32) Num {
 // is ignored. If you wish to prevent data loss, use Rescale which will
 // return an error if data loss is detected.
 func (n Num) ReduceScaleBy(reduce int32, round bool) Num {
-	debug.Assert(reduce >= 0, "invalid reduce scale for decimal128")
-	debug.Assert(reduce <= 38, "invalid reduce scale for decimal128")
-
-	if reduce == 0 {
-		return n
-	}
-
-	divisor := scaleMultipliers[reduce].BigInt()
-	result, remainder := divisor.QuoRem(n.BigInt(), divisor, (&big.Int{}))
+	mode := RoundDown
 	if round {
-		divisorHalf := scaleMultipliersHalf[reduce]
-		if remainder.Abs(remainder).Cmp(divisorHalf.BigInt()) != -1 {
-			result.Add(result, big.NewInt(int64(n.Sign())))
-		}
-	}
-	return FromBigInt(result)
-}
-
-func (n Num) rescaleWouldCauseDataLoss(deltaScale int32, multiplier Num) (out Num, loss bool) {
-	var (
-		value, result, remainder *big.Int
-	)
-	value = n.BigInt()
-	if deltaScale < 0 {
-		debug.Assert(multiplier.lo != 0 || multiplier.hi != 0, "multiplier needs to not be zero")
-		result, remainder = (&big.Int{}).QuoRem(value, multiplier.BigInt(), (&big.Int{}))
-		return FromBigInt(result), remainder.Cmp(big.NewInt(0)) != 0
-	}
-
-	result = (&big.Int{}).Mul(value, multiplier.BigInt())
-	out = FromBigInt(result)
-	cmp := result.Cmp(value)
-	if n.Sign() < 0 {
-		loss = cmp == 1
-	} else {
-		loss = cmp == -1
+		mode = RoundHalfUp
 	}
-	return
+	return n.ReduceScaleByMode(reduce, mode)
 }
 
 // Rescale returns a new decimal128.Num with the value updated assuming
 // the current value is scaled to originalScale with the new value scaled
 // to newScale. If rescaling this way would cause data loss, an error is
-// returned instead.
-func (n Num) Rescale(originalScale, newScale int32) (out Num, err error) {
-	if originalScale == newScale {
-		return n, nil
+// returned instead. Use RescaleMode to round instead of erroring out.
+func (n Num) Rescale(originalScale, newScale int32) (Num, error) {
+	out, err := n.RescaleMode(originalScale, newScale, RoundUnnecessary)
+	if err != nil {
+		return Num{}, errors.New("rescale data loss")
 	}
-
-	deltaScale := newScale - originalScale
-	absDeltaScale := int32(math.Abs(float64(deltaScale)))
-
-	multiplier := scaleMultipliers[absDeltaScale]
-	var wouldHaveLoss bool
-	out, wouldHaveLoss = n.rescaleWouldCauseDataLoss(deltaScale, multiplier)
-	if wouldHaveLoss {
-		err = errors.New("rescale data loss")
-	}
-	return
+	return out, nil
 }
 
 // Abs returns a new decimal128.Num that contains the absolute value of n
@@ -324,7 +436,6 @@ var (
 		FromU64(100000000000000000),
 		FromU64(1000000000000000000),
 		FromU64(10000000000000000000),
-		New(0, 10000000000000000000),
 		New(5, 7766279631452241920),
 		New(54, 3875820019684212736),
 		New(542, 1864712049423024128),
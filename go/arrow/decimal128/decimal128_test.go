@@ -0,0 +1,111 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decimal128
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+// TestScaleMultipliers guards against the off-by-one that once shifted every
+// entry of scaleMultipliers past index 19 down by one power of ten: each
+// entry k must hold exactly 10^k.
+func TestScaleMultipliers(t *testing.T) {
+	want := big.NewInt(1)
+	ten := big.NewInt(10)
+	for k := 0; k <= 38; k++ {
+		if got := scaleMultipliers[k].BigInt(); got.Cmp(want) != 0 {
+			t.Fatalf("scaleMultipliers[%d] = %s, want %s", k, got, want)
+		}
+		half := new(big.Int).Rsh(want, 1)
+		if got := scaleMultipliersHalf[k].BigInt(); got.Cmp(half) != 0 {
+			t.Fatalf("scaleMultipliersHalf[%d] = %s, want %s", k, got, half)
+		}
+		want.Mul(want, ten)
+	}
+}
+
+func bigOf(n Num) *big.Int { return n.BigInt() }
+
+func TestArithmeticVsBigInt(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	mask := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+	signed := func(v *big.Int) *big.Int {
+		v = new(big.Int).And(v, mask)
+		if v.Bit(127) == 1 {
+			v.Sub(v, new(big.Int).Lsh(big.NewInt(1), 128))
+		}
+		return v
+	}
+	randNum := func() (Num, *big.Int) {
+		hi := int64(r.Uint64())
+		lo := r.Uint64()
+		n := New(hi, lo)
+		return n, signed(n.BigInt())
+	}
+
+	for i := 0; i < 10000; i++ {
+		a, abig := randNum()
+		b, bbig := randNum()
+
+		if got, want := bigOf(a.Add(b)), signed(new(big.Int).Add(abig, bbig)); got.Cmp(want) != 0 {
+			t.Fatalf("Add: %s + %s = %s, want %s", abig, bbig, got, want)
+		}
+		if got, want := bigOf(a.Sub(b)), signed(new(big.Int).Sub(abig, bbig)); got.Cmp(want) != 0 {
+			t.Fatalf("Sub: %s - %s = %s, want %s", abig, bbig, got, want)
+		}
+		if got, want := bigOf(a.Mul(b)), signed(new(big.Int).Mul(abig, bbig)); got.Cmp(want) != 0 {
+			t.Fatalf("Mul: %s * %s = %s, want %s", abig, bbig, got, want)
+		}
+		if b != (Num{}) {
+			q, rem := a.QuoRem(b)
+			wantQ, wantR := new(big.Int).QuoRem(abig, bbig, new(big.Int))
+			if bigOf(q).Cmp(wantQ) != 0 || bigOf(rem).Cmp(wantR) != 0 {
+				t.Fatalf("QuoRem: %s / %s = (%s, %s), want (%s, %s)", abig, bbig, bigOf(q), bigOf(rem), wantQ, wantR)
+			}
+		}
+
+		var wantCmp int
+		switch abig.Cmp(bbig) {
+		case -1:
+			wantCmp = -1
+		case 1:
+			wantCmp = 1
+		}
+		if got := a.Cmp(b); got != wantCmp {
+			t.Fatalf("Cmp: %s vs %s = %d, want %d", abig, bbig, got, wantCmp)
+		}
+
+		s := uint(r.Intn(128))
+		if got, want := bigOf(a.Lsh(s)), signed(new(big.Int).Lsh(abig, s)); got.Cmp(want) != 0 {
+			t.Fatalf("Lsh: %s << %d = %s, want %s", abig, s, got, want)
+		}
+		if got, want := bigOf(a.Rsh(s)), signed(new(big.Int).Rsh(abig, s)); got.Cmp(want) != 0 {
+			t.Fatalf("Rsh: %s >> %d = %s, want %s", abig, s, got, want)
+		}
+	}
+}
+
+func TestQuoRemDivByZeroPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic on division by zero")
+		}
+	}()
+	FromI64(1).QuoRem(Num{})
+}
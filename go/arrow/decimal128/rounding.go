@@ -0,0 +1,184 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decimal128
+
+import (
+	"errors"
+
+	"github.com/apache/arrow/go/v10/arrow/internal/debug"
+)
+
+// RoundingMode controls how ReduceScaleByMode and RescaleMode behave when
+// reducing the scale of a Num would otherwise discard non-zero digits.
+// The names and semantics follow java.math.RoundingMode, which most SQL
+// engines and decimal libraries already mirror.
+type RoundingMode int
+
+const (
+	// RoundHalfEven rounds to the nearest value; on an exact tie, rounds to
+	// the neighbor with an even least-significant bit (a.k.a. "banker's
+	// rounding"). This is the rounding mode IEEE 754 and most SQL engines
+	// default to.
+	RoundHalfEven RoundingMode = iota
+	// RoundHalfUp rounds to the nearest value; on an exact tie, rounds away
+	// from zero.
+	RoundHalfUp
+	// RoundHalfDown rounds to the nearest value; on an exact tie, rounds
+	// towards zero.
+	RoundHalfDown
+	// RoundDown truncates towards zero, discarding any fractional digits.
+	RoundDown
+	// RoundUp rounds away from zero whenever a discarded digit is non-zero.
+	RoundUp
+	// RoundCeiling rounds towards positive infinity.
+	RoundCeiling
+	// RoundFloor rounds towards negative infinity.
+	RoundFloor
+	// RoundUnnecessary asserts that the operation is exact, returning an
+	// error (or, for the error-less ReduceScaleByMode, panicking) if a
+	// non-zero digit would actually be discarded.
+	RoundUnnecessary
+)
+
+func (m RoundingMode) String() string {
+	switch m {
+	case RoundHalfEven:
+		return "RoundHalfEven"
+	case RoundHalfUp:
+		return "RoundHalfUp"
+	case RoundHalfDown:
+		return "RoundHalfDown"
+	case RoundDown:
+		return "RoundDown"
+	case RoundUp:
+		return "RoundUp"
+	case RoundCeiling:
+		return "RoundCeiling"
+	case RoundFloor:
+		return "RoundFloor"
+	case RoundUnnecessary:
+		return "RoundUnnecessary"
+	default:
+		return "RoundingMode(?)"
+	}
+}
+
+// round applies mode to a quotient/remainder pair produced by truncating
+// (towards zero) division of a value with the given sign by divisor, whose
+// precomputed half is divisorHalf. It returns the rounded quotient, and
+// whether mode was RoundUnnecessary while remainder was actually non-zero.
+func round(quotient, remainder, divisorHalf Num, sign int, mode RoundingMode) (out Num, violatesUnnecessary bool) {
+	if remainder.Sign() == 0 {
+		return quotient, false
+	}
+
+	bumpAwayFromZero := func() Num {
+		if sign < 0 {
+			return quotient.Sub(FromI64(1))
+		}
+		return quotient.Add(FromI64(1))
+	}
+
+	switch mode {
+	case RoundDown:
+		return quotient, false
+	case RoundUp:
+		return bumpAwayFromZero(), false
+	case RoundCeiling:
+		if sign >= 0 {
+			return bumpAwayFromZero(), false
+		}
+		return quotient, false
+	case RoundFloor:
+		if sign < 0 {
+			return bumpAwayFromZero(), false
+		}
+		return quotient, false
+	case RoundUnnecessary:
+		return quotient, true
+	case RoundHalfUp, RoundHalfDown, RoundHalfEven:
+		switch remainder.Abs().Cmp(divisorHalf) {
+		case 1:
+			return bumpAwayFromZero(), false
+		case -1:
+			return quotient, false
+		default: // exact tie
+			switch mode {
+			case RoundHalfUp:
+				return bumpAwayFromZero(), false
+			case RoundHalfDown:
+				return quotient, false
+			default: // RoundHalfEven
+				if quotient.lo&1 == 1 {
+					return bumpAwayFromZero(), false
+				}
+				return quotient, false
+			}
+		}
+	default:
+		panic("arrow/decimal128: invalid RoundingMode")
+	}
+}
+
+// ReduceScaleByMode returns a new decimal128.Num with the value scaled down
+// by the desired amount (0 <= reduce <= 38), using mode to decide how any
+// discarded digits affect the result. Panics if mode is RoundUnnecessary
+// and a non-zero digit would actually be discarded.
+func (n Num) ReduceScaleByMode(reduce int32, mode RoundingMode) Num {
+	debug.Assert(reduce >= 0, "invalid reduce scale for decimal128")
+	debug.Assert(reduce <= 38, "invalid reduce scale for decimal128")
+
+	if reduce == 0 {
+		return n
+	}
+
+	quotient, remainder := n.QuoRem(scaleMultipliers[reduce])
+	out, violates := round(quotient, remainder, scaleMultipliersHalf[reduce], n.Sign(), mode)
+	if violates {
+		panic("arrow/decimal128: rounding necessary but mode is RoundUnnecessary")
+	}
+	return out
+}
+
+// RescaleMode returns a new decimal128.Num with the value updated assuming
+// the current value is scaled to originalScale with the new value scaled
+// to newScale, using mode to decide how any discarded digits affect the
+// result when newScale < originalScale. Returns an error if mode is
+// RoundUnnecessary and a non-zero digit would actually be discarded, or if
+// increasing the scale would overflow 128 bits.
+func (n Num) RescaleMode(originalScale, newScale int32, mode RoundingMode) (Num, error) {
+	if originalScale == newScale {
+		return n, nil
+	}
+
+	if newScale > originalScale {
+		increase := newScale - originalScale
+		out := n.IncreaseScaleBy(increase)
+		if back, _ := out.QuoRem(scaleMultipliers[increase]); back != n {
+			return Num{}, errors.New("rescale data loss")
+		}
+		return out, nil
+	}
+
+	reduce := originalScale - newScale
+	quotient, remainder := n.QuoRem(scaleMultipliers[reduce])
+	out, violates := round(quotient, remainder, scaleMultipliersHalf[reduce], n.Sign(), mode)
+	if violates {
+		return Num{}, errors.New("rescale data loss")
+	}
+	return out, nil
+}
@@ -0,0 +1,161 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decimal128
+
+import "testing"
+
+func TestReduceScaleByModeHalfModes(t *testing.T) {
+	for _, tc := range []struct {
+		mode RoundingMode
+		in   int64
+		want int64
+	}{
+		// 125 / 10, remainder 5 -> exact tie at each half-mode.
+		{RoundHalfUp, 125, 13},
+		{RoundHalfDown, 125, 12},
+		{RoundHalfEven, 125, 12}, // ties to even: 12 is even
+		{RoundHalfEven, 135, 14}, // ties to even: 14 is even (13 is odd)
+		// non-tie remainders round the same regardless of half-mode.
+		{RoundHalfUp, 124, 12},
+		{RoundHalfUp, 126, 13},
+		// negative ties: HalfDown goes towards zero, HalfUp away from zero.
+		{RoundHalfDown, -125, -12},
+		{RoundHalfUp, -125, -13},
+	} {
+		got := FromI64(tc.in).ReduceScaleByMode(1, tc.mode)
+		if want := FromI64(tc.want); got != want {
+			t.Fatalf("%v.ReduceScaleByMode(1, %s) = %s, want %s", tc.in, tc.mode, got.ToString(0), want.ToString(0))
+		}
+	}
+}
+
+func TestReduceScaleByModeDirectional(t *testing.T) {
+	for _, tc := range []struct {
+		mode RoundingMode
+		in   int64
+		want int64
+	}{
+		{RoundDown, 129, 12},
+		{RoundDown, -129, -12},
+		{RoundUp, 121, 13},
+		{RoundUp, -121, -13},
+		{RoundCeiling, 121, 13},
+		{RoundCeiling, -121, -12},
+		{RoundFloor, 121, 12},
+		{RoundFloor, -121, -13},
+	} {
+		got := FromI64(tc.in).ReduceScaleByMode(1, tc.mode)
+		if want := FromI64(tc.want); got != want {
+			t.Fatalf("%v.ReduceScaleByMode(1, %s) = %s, want %s", tc.in, tc.mode, got.ToString(0), want.ToString(0))
+		}
+	}
+}
+
+func TestReduceScaleByModeExact(t *testing.T) {
+	// No digits discarded: every mode, including RoundUnnecessary, agrees.
+	for _, mode := range []RoundingMode{RoundHalfEven, RoundHalfUp, RoundHalfDown, RoundDown, RoundUp, RoundCeiling, RoundFloor, RoundUnnecessary} {
+		got := FromI64(120).ReduceScaleByMode(1, mode)
+		if want := FromI64(12); got != want {
+			t.Fatalf("mode %s: got %s, want 12", mode, got.ToString(0))
+		}
+	}
+}
+
+func TestReduceScaleByModeUnnecessaryPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic when RoundUnnecessary discards a non-zero digit")
+		}
+	}()
+	FromI64(121).ReduceScaleByMode(1, RoundUnnecessary)
+}
+
+func TestReduceScaleByDelegatesToMode(t *testing.T) {
+	if got, want := FromI64(125).ReduceScaleBy(1, false), FromI64(12); got != want {
+		t.Fatalf("ReduceScaleBy(round=false) = %s, want %s", got.ToString(0), want.ToString(0))
+	}
+	if got, want := FromI64(125).ReduceScaleBy(1, true), FromI64(13); got != want {
+		t.Fatalf("ReduceScaleBy(round=true) = %s, want %s", got.ToString(0), want.ToString(0))
+	}
+	if got := FromI64(125).ReduceScaleBy(0, true); got != FromI64(125) {
+		t.Fatalf("ReduceScaleBy(0, ...) = %s, want unchanged", got.ToString(0))
+	}
+}
+
+func TestRescaleMode(t *testing.T) {
+	out, err := FromI64(125).RescaleMode(2, 1, RoundHalfUp)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := FromI64(13); out != want {
+		t.Fatalf("got %s, want %s", out.ToString(1), want.ToString(1))
+	}
+
+	out, err = FromI64(12).RescaleMode(0, 2, RoundHalfUp)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := FromI64(1200); out != want {
+		t.Fatalf("got %s, want %s", out.ToString(2), want.ToString(2))
+	}
+
+	if _, err := FromI64(121).RescaleMode(1, 0, RoundUnnecessary); err == nil {
+		t.Fatal("expected error for lossy rescale with RoundUnnecessary")
+	}
+
+	out, err = FromI64(7).RescaleMode(3, 3, RoundHalfUp)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out != FromI64(7) {
+		t.Fatalf("RescaleMode with equal scales should be a no-op, got %s", out.ToString(3))
+	}
+}
+
+func TestRescaleDelegatesToMode(t *testing.T) {
+	if _, err := FromI64(121).Rescale(1, 0); err == nil {
+		t.Fatal("expected error: Rescale is lossless-only")
+	}
+	out, err := FromI64(120).Rescale(1, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := FromI64(12); out != want {
+		t.Fatalf("got %s, want %s", out.ToString(0), want.ToString(0))
+	}
+}
+
+func TestRoundingModeString(t *testing.T) {
+	for _, tc := range []struct {
+		mode RoundingMode
+		want string
+	}{
+		{RoundHalfEven, "RoundHalfEven"},
+		{RoundHalfUp, "RoundHalfUp"},
+		{RoundHalfDown, "RoundHalfDown"},
+		{RoundDown, "RoundDown"},
+		{RoundUp, "RoundUp"},
+		{RoundCeiling, "RoundCeiling"},
+		{RoundFloor, "RoundFloor"},
+		{RoundUnnecessary, "RoundUnnecessary"},
+		{RoundingMode(99), "RoundingMode(?)"},
+	} {
+		if got := tc.mode.String(); got != tc.want {
+			t.Fatalf("RoundingMode(%d).String() = %s, want %s", tc.mode, got, tc.want)
+		}
+	}
+}
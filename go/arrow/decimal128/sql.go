@@ -0,0 +1,193 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decimal128
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/big"
+)
+
+// Decimal pairs a Num with the precision and scale needed to interpret it,
+// turning it into a self-describing scalar that can flow through
+// database/sql drivers (Postgres NUMERIC, Snowflake NUMBER, MySQL DECIMAL,
+// ...) and JSON payloads. The zero value has precision and scale 0, so
+// callers that need a specific precision/scale should set them before
+// Scan/UnmarshalText/UnmarshalJSON are used, e.g.:
+//
+//	d := decimal128.Decimal{Precision: 18, Scale: 4}
+//	if err := rows.Scan(&d); err != nil { ... }
+type Decimal struct {
+	Num
+	Precision int32
+	Scale     int32
+}
+
+// Value implements driver.Valuer, returning the canonical base-10 string
+// representation of d so that it can be written through any database/sql
+// driver that accepts a string for a decimal/numeric column.
+func (d Decimal) Value() (driver.Value, error) {
+	return d.ToString(d.Scale), nil
+}
+
+// Scan implements sql.Scanner, accepting string, []byte, int64, float64, and
+// *big.Rat source values, as produced by common database/sql drivers.
+func (d *Decimal) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		d.Num = Num{}
+	case string:
+		n, err := FromString(v, d.Precision, d.Scale)
+		if err != nil {
+			return err
+		}
+		d.Num = n
+	case []byte:
+		n, err := FromString(string(v), d.Precision, d.Scale)
+		if err != nil {
+			return err
+		}
+		d.Num = n
+	case int64:
+		n := FromI64(v)
+		if d.Scale > 0 {
+			n = n.IncreaseScaleBy(d.Scale)
+		}
+		if !n.FitsInPrecision(d.Precision) {
+			return fmt.Errorf("arrow/decimal128: %d overflows decimal128(precision=%d, scale=%d)", v, d.Precision, d.Scale)
+		}
+		d.Num = n
+	case float64:
+		n, err := FromFloat64(v, d.Precision, d.Scale)
+		if err != nil {
+			return err
+		}
+		d.Num = n
+	case *big.Rat:
+		n, err := fromBigRat(v, d.Precision, d.Scale)
+		if err != nil {
+			return err
+		}
+		d.Num = n
+	default:
+		return fmt.Errorf("arrow/decimal128: cannot scan %T into Decimal", value)
+	}
+	return nil
+}
+
+func fromBigRat(r *big.Rat, prec, scale int32) (Num, error) {
+	if scale < 0 || scale > 38 {
+		return Num{}, fmt.Errorf("arrow/decimal128: invalid scale %d", scale)
+	}
+
+	numer := new(big.Int).Mul(r.Num(), scaleMultipliers[scale].BigInt())
+	quo, rem := new(big.Int).QuoRem(numer, r.Denom(), new(big.Int))
+
+	// round half away from zero, same convention as ReduceScaleBy(round=true)
+	twice := new(big.Int).Lsh(rem.Abs(rem), 1)
+	if twice.Cmp(r.Denom()) >= 0 {
+		if numer.Sign() < 0 {
+			quo.Sub(quo, big.NewInt(1))
+		} else {
+			quo.Add(quo, big.NewInt(1))
+		}
+	}
+
+	if quo.BitLen() > 127 {
+		return Num{}, fmt.Errorf("arrow/decimal128: value %s overflows decimal128", r.RatString())
+	}
+
+	n := FromBigInt(quo)
+	if !n.FitsInPrecision(prec) {
+		return Num{}, fmt.Errorf("arrow/decimal128: value %s overflows decimal128(precision=%d, scale=%d)", r.RatString(), prec, scale)
+	}
+	return n, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, returning the canonical
+// base-10 string representation of d.
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.ToString(d.Scale)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. d.Precision and
+// d.Scale must already be set to the desired target before calling this,
+// since the text itself carries no scale information.
+func (d *Decimal) UnmarshalText(text []byte) error {
+	n, err := FromString(string(text), d.Precision, d.Scale)
+	if err != nil {
+		return err
+	}
+	d.Num = n
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding d as a bare JSON number.
+// Use AsText(d) instead when the JSON consumer (e.g. JavaScript, whose
+// numbers are float64-based) needs the full precision preserved.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(d.ToString(d.Scale)), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting either a bare JSON
+// number or a quoted string (as produced by AsText). d.Precision and
+// d.Scale must already be set to the desired target before calling this.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	var s string
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+	} else {
+		s = string(data)
+	}
+
+	n, err := FromString(s, d.Precision, d.Scale)
+	if err != nil {
+		return err
+	}
+	d.Num = n
+	return nil
+}
+
+// AsText wraps a Decimal so that it marshals to and from JSON as a quoted
+// string rather than a bare number, preserving full precision for
+// consumers (such as JavaScript) whose numeric types cannot hold it exactly.
+type AsText Decimal
+
+// MarshalJSON implements json.Marshaler, encoding the wrapped Decimal as a
+// quoted base-10 string.
+func (d AsText) MarshalJSON() ([]byte, error) {
+	return json.Marshal(Decimal(d).ToString(d.Scale))
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding a quoted base-10
+// string into the wrapped Decimal. Precision and Scale must already be set
+// to the desired target before calling this.
+func (d *AsText) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	n, err := FromString(s, d.Precision, d.Scale)
+	if err != nil {
+		return err
+	}
+	d.Num = n
+	return nil
+}
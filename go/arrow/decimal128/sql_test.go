@@ -0,0 +1,136 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decimal128
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+)
+
+func TestDecimalValue(t *testing.T) {
+	d := Decimal{Num: FromI64(123456), Precision: 18, Scale: 2}
+	v, err := d.Value()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v != "1234.56" {
+		t.Fatalf("got %v, want 1234.56", v)
+	}
+}
+
+func TestDecimalScan(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		value interface{}
+		want  string
+	}{
+		{"string", "12.34", "12.34"},
+		{"bytes", []byte("12.34"), "12.34"},
+		{"int64", int64(7), "7.00"},
+		{"float64", 12.34, "12.34"},
+		{"big.Rat", big.NewRat(1234, 100), "12.34"},
+		{"nil", nil, "0.00"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Decimal{Precision: 18, Scale: 2}
+			if err := d.Scan(tc.value); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := d.ToString(d.Scale); got != tc.want {
+				t.Fatalf("got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDecimalScanUnsupportedType(t *testing.T) {
+	d := Decimal{Precision: 18, Scale: 2}
+	if err := d.Scan(struct{}{}); err == nil {
+		t.Fatal("expected error for unsupported Scan type")
+	}
+}
+
+func TestDecimalTextRoundTrip(t *testing.T) {
+	d := Decimal{Num: FromI64(123456), Precision: 18, Scale: 2}
+	text, err := d.MarshalText()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(text) != "1234.56" {
+		t.Fatalf("got %s, want 1234.56", text)
+	}
+
+	var got Decimal
+	got.Precision, got.Scale = 18, 2
+	if err := got.UnmarshalText(text); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Num != d.Num {
+		t.Fatalf("got %s, want %s", got.ToString(2), d.ToString(2))
+	}
+}
+
+func TestDecimalJSONRoundTrip(t *testing.T) {
+	d := Decimal{Num: FromI64(123456), Precision: 18, Scale: 2}
+	data, err := json.Marshal(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(data) != "1234.56" {
+		t.Fatalf("got %s, want 1234.56", data)
+	}
+
+	var got Decimal
+	got.Precision, got.Scale = 18, 2
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Num != d.Num {
+		t.Fatalf("got %s, want %s", got.ToString(2), d.ToString(2))
+	}
+
+	// A quoted string (as AsText would produce) must also unmarshal.
+	var got2 Decimal
+	got2.Precision, got2.Scale = 18, 2
+	if err := json.Unmarshal([]byte(`"1234.56"`), &got2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got2.Num != d.Num {
+		t.Fatalf("got %s, want %s", got2.ToString(2), d.ToString(2))
+	}
+}
+
+func TestAsTextJSONRoundTrip(t *testing.T) {
+	d := AsText{Num: FromI64(123456), Precision: 18, Scale: 2}
+	data, err := json.Marshal(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(data) != `"1234.56"` {
+		t.Fatalf("got %s, want \"1234.56\"", data)
+	}
+
+	var got AsText
+	got.Precision, got.Scale = 18, 2
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Num != d.Num {
+		t.Fatalf("got %s, want %s", Decimal(got).ToString(2), Decimal(d).ToString(2))
+	}
+}
@@ -0,0 +1,197 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decimal128
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FromString parses s as a base-10 decimal and returns the Num representing
+// it at the given scale, erroring out if s is not a valid decimal string or
+// if the value does not fit in a decimal128 of the given precision and
+// scale.
+//
+// The accepted grammar is an optional sign, a run of digits, an optional
+// '.' followed by a run of digits, and an optional exponent ('e' or 'E'
+// followed by an optionally-signed integer):
+//
+//	[+-]?digits(\.digits)?([eE][+-]?digits)?
+//
+// Excess fractional digits beyond scale are rounded using the same
+// half-away-from-zero rule as ReduceScaleBy(reduce, true).
+func FromString(s string, prec, scale int32) (out Num, err error) {
+	orig := s
+	if s == "" {
+		return Num{}, fmt.Errorf("arrow/decimal128: cannot parse empty string as decimal")
+	}
+
+	neg := false
+	switch s[0] {
+	case '+':
+		s = s[1:]
+	case '-':
+		neg = true
+		s = s[1:]
+	}
+
+	exp := int64(0)
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		exp, err = strconv.ParseInt(s[i+1:], 10, 32)
+		if err != nil {
+			return Num{}, fmt.Errorf("arrow/decimal128: invalid exponent in %q: %w", orig, err)
+		}
+		s = s[:i]
+	}
+
+	intPart, fracPart := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		intPart, fracPart = s[:i], s[i+1:]
+		if fracPart == "" {
+			return Num{}, fmt.Errorf("arrow/decimal128: invalid decimal string %q", orig)
+		}
+	}
+
+	if intPart == "" || !isDigits(intPart) || !isDigits(fracPart) {
+		return Num{}, fmt.Errorf("arrow/decimal128: invalid decimal string %q", orig)
+	}
+
+	digits := intPart + fracPart
+
+	// cut is how many trailing digits of "digits" need to be dropped (with
+	// rounding) to land on the requested scale; negative means "digits" needs
+	// that many trailing zeros appended instead. We resolve this at the
+	// string level, before ever building a Num, so that a long run of
+	// insignificant digits (e.g. a huge negative exponent, or fractional
+	// digits far beyond scale) can never silently wrap the 128-bit
+	// accumulator the way building the full unscaled integer first would.
+	impliedScale := int64(len(fracPart)) - exp
+	cut := impliedScale - int64(scale)
+
+	var kept string
+	roundUp := false
+	switch {
+	case strings.TrimLeft(digits, "0") == "":
+		// The value is exactly zero regardless of how large cut is (e.g. a
+		// huge exponent on an all-zero mantissa), so skip the cut/overflow
+		// arithmetic below entirely.
+		kept = "0"
+	case cut <= 0 && int64(len(digits))-cut > 100:
+		return Num{}, fmt.Errorf("arrow/decimal128: value %q overflows decimal128(precision=%d, scale=%d)", orig, prec, scale)
+	case cut <= 0:
+		kept = digits + strings.Repeat("0", int(-cut))
+	case cut < int64(len(digits)):
+		kept = digits[:int64(len(digits))-cut]
+		roundUp = digits[int64(len(digits))-cut] >= '5'
+	default: // cut >= len(digits): the entire value (and then some) is dropped
+		kept = "0"
+		if cut == int64(len(digits)) {
+			roundUp = digits[0] >= '5'
+		}
+		// else cut > len(digits): the guard digit is an implicit leading
+		// zero, so the dropped magnitude is provably < half and never
+		// rounds up.
+	}
+
+	if sig := strings.TrimLeft(kept, "0"); len(sig) > 38 {
+		return Num{}, fmt.Errorf("arrow/decimal128: value %q overflows decimal128", orig)
+	}
+
+	for i := 0; i < len(kept); i++ {
+		out = out.Mul(scaleMultipliers[1]).Add(FromU64(uint64(kept[i] - '0')))
+	}
+	if roundUp {
+		out = out.Add(FromU64(1))
+	}
+
+	if neg {
+		out = out.Negate()
+	}
+
+	if !out.FitsInPrecision(prec) {
+		return Num{}, fmt.Errorf("arrow/decimal128: value %q overflows decimal128(precision=%d, scale=%d)", orig, prec, scale)
+	}
+	return out, nil
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// ToString returns the base-10 representation of n, treating it as a
+// decimal value scaled by scale (i.e. with the decimal point inserted
+// scale digits from the right). No decimal point is emitted when scale
+// is 0.
+func (n Num) ToString(scale int32) string {
+	digits := n.Abs().digits()
+
+	var b strings.Builder
+	if n.Sign() < 0 {
+		b.WriteByte('-')
+	}
+
+	switch {
+	case scale <= 0:
+		b.WriteString(digits)
+		b.WriteString(strings.Repeat("0", int(-scale)))
+	default:
+		if len(digits) <= int(scale) {
+			digits = strings.Repeat("0", int(scale)-len(digits)+1) + digits
+		}
+		cut := len(digits) - int(scale)
+		b.WriteString(digits[:cut])
+		b.WriteByte('.')
+		b.WriteString(digits[cut:])
+	}
+	return b.String()
+}
+
+// digits returns the base-10 digits of a non-negative n, with no leading
+// zeros (other than a single "0" for a zero value).
+func (n Num) digits() string {
+	if n == (Num{}) {
+		return "0"
+	}
+
+	var buf [39]byte
+	i := len(buf)
+	ten := scaleMultipliers[1]
+	for n != (Num{}) {
+		var r Num
+		n, r = n.QuoRem(ten)
+		i--
+		buf[i] = byte('0' + r.lo)
+	}
+	return string(buf[i:])
+}
+
+// Format is a convenience alias for ToString.
+func (n Num) Format(scale int32) string {
+	return n.ToString(scale)
+}
+
+// String returns the base-10 representation of n as a plain integer
+// (equivalent to ToString(0)), satisfying fmt.Stringer.
+func (n Num) String() string {
+	return n.ToString(0)
+}
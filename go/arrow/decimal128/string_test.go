@@ -0,0 +1,165 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decimal128
+
+import (
+	"math/big"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestFromStringReproCases(t *testing.T) {
+	// Regression for the scaleMultipliers off-by-one: this targets scale 37
+	// from an implied scale of 65, cutting 28 excess digits.
+	n, err := FromString("3807.722128258538561561419482071e-38", 18, 37)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := n.ToString(37); got != "0.0000000000000000000000000000000000381" {
+		t.Fatalf("got %s, want 0.0000000000000000000000000000000000381", got)
+	}
+
+	// Regression for the premature raw-digit overflow check: 40 significant
+	// digits overflows decimal128 before rounding, but rounds up to 10, a
+	// 2-digit value that fits.
+	n2, err := FromString("9."+strings.Repeat("9", 39), 38, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := n2.ToString(0); got != "10" {
+		t.Fatalf("got %s, want 10", got)
+	}
+}
+
+func TestFromStringOverflow(t *testing.T) {
+	if _, err := FromString(strings.Repeat("9", 39), 38, 0); err == nil {
+		t.Fatal("expected overflow error for a genuinely too-large integer part")
+	}
+	if _, err := FromString("1"+strings.Repeat("0", 1000), 38, 0); err == nil {
+		t.Fatal("expected overflow error for a 1001-digit non-zero integer")
+	}
+}
+
+func TestFromStringZero(t *testing.T) {
+	for _, s := range []string{"0", "-0", "0.000", "0e1000000000", "-0e-1000000000"} {
+		n, err := FromString(s, 38, 2)
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %v", s, err)
+		}
+		if n != (Num{}) {
+			t.Fatalf("%q: got %s, want 0", s, n.ToString(2))
+		}
+	}
+}
+
+func TestFromStringRoundTrip(t *testing.T) {
+	for _, tc := range []struct {
+		s     string
+		scale int32
+		want  string
+	}{
+		{"123.456", 2, "123.46"},
+		{"123.454", 2, "123.45"},
+		{"123.455", 2, "123.46"},
+		{"-123.455", 2, "-123.46"},
+		{"1e2", 0, "100"},
+		{"1e-3", 2, "0.00"},
+		{"5e-1", 0, "1"},
+		{"-5e-1", 0, "-1"},
+	} {
+		n, err := FromString(tc.s, 38, tc.scale)
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %v", tc.s, err)
+		}
+		if got := n.ToString(tc.scale); got != tc.want {
+			t.Fatalf("FromString(%q, scale=%d) = %s, want %s", tc.s, tc.scale, got, tc.want)
+		}
+	}
+}
+
+// TestFromStringVsBigRat differentially tests FromString against math/big
+// over random decimal strings and scales. The strings carry no exponent, so
+// they reach the zero, pad-with-zeros and cut-and-round branches of the
+// cut/kept logic, but not the >100-digit guard or the drop-everything branch.
+func TestFromStringVsBigRat(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	for i := 0; i < 20000; i++ {
+		var sb strings.Builder
+		if r.Intn(2) == 0 {
+			sb.WriteByte('-')
+		}
+		intLen := r.Intn(15)
+		if intLen == 0 {
+			sb.WriteByte('0')
+		}
+		for j := 0; j < intLen; j++ {
+			sb.WriteByte(byte('0' + r.Intn(10)))
+		}
+		fracLen := r.Intn(45)
+		if fracLen > 0 {
+			sb.WriteByte('.')
+			for j := 0; j < fracLen; j++ {
+				sb.WriteByte(byte('0' + r.Intn(10)))
+			}
+		}
+		s := sb.String()
+		scale := int32(r.Intn(20))
+
+		got, err := FromString(s, 38, scale)
+
+		rat, ok := new(big.Rat).SetString(s)
+		if !ok {
+			t.Fatalf("bad test string %q", s)
+		}
+		mul := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil)
+		num := new(big.Int).Mul(rat.Num(), mul)
+		quo, rem := new(big.Int).QuoRem(num, rat.Denom(), new(big.Int))
+		twice := new(big.Int).Lsh(new(big.Int).Abs(rem), 1)
+		if twice.Cmp(rat.Denom()) >= 0 {
+			if num.Sign() < 0 {
+				quo.Sub(quo, big.NewInt(1))
+			} else {
+				quo.Add(quo, big.NewInt(1))
+			}
+		}
+
+		limit := new(big.Int).Exp(big.NewInt(10), big.NewInt(38), nil)
+		fits := new(big.Int).Abs(quo).Cmp(limit) < 0
+
+		if !fits {
+			if err == nil {
+				t.Fatalf("s=%q scale=%d: expected overflow error, got %s", s, scale, got.ToString(scale))
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("s=%q scale=%d: unexpected error %v (want %s)", s, scale, err, quo.String())
+		}
+		if want := FromBigInt(quo); got != want {
+			t.Fatalf("s=%q scale=%d: got %s, want %s", s, scale, got.ToString(scale), quo.String())
+		}
+	}
+}
+
+func TestFromStringInvalid(t *testing.T) {
+	for _, s := range []string{"", "abc", "1.2.3", ".", "1e", "1e1.5", "-"} {
+		if _, err := FromString(s, 38, 0); err == nil {
+			t.Fatalf("%q: expected error", s)
+		}
+	}
+}